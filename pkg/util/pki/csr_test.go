@@ -277,6 +277,62 @@ func TestRemoveDuplicates(t *testing.T) {
 	}
 }
 
+func TestIPAddressesToString(t *testing.T) {
+	tests := map[string]struct {
+		input  []net.IP
+		output []string
+	}{
+		"nil slice": {
+			input:  nil,
+			output: nil,
+		},
+		"IPv4 address": {
+			input:  []net.IP{net.ParseIP("10.0.0.1")},
+			output: []string{"10.0.0.1"},
+		},
+		"IPv6 address with zero groups is collapsed": {
+			input:  []net.IP{net.ParseIP("2001:0db8:0000:0000:0000:0000:0000:0001")},
+			output: []string{"2001:db8::1"},
+		},
+		"IPv6 address with leading zeros and mixed case is normalised": {
+			input:  []net.IP{net.ParseIP("2001:0DB8:0000:0001:0000:0000:00A0:0001")},
+			output: []string{"2001:db8:0:1::a0:1"},
+		},
+		"IPv6 address with a single zero group is not collapsed": {
+			input:  []net.IP{net.ParseIP("2001:db8:0:1:1:1:1:1")},
+			output: []string{"2001:db8:0:1:1:1:1:1"},
+		},
+		"multiple addresses": {
+			input:  []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("::1")},
+			output: []string{"10.0.0.1", "::1"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.output, IPAddressesToString(test.input))
+		})
+	}
+}
+
+func TestIPAddressesToStringFromCertificate(t *testing.T) {
+	pk, err := GenerateECPrivateKey(256)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("2001:0db8:0000:0000:0000:0000:0000:0001")},
+	}
+	derBytes, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(derBytes)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"2001:db8::1"}, IPAddressesToString(cert.IPAddresses))
+}
+
 func removeDuplicates(in []string) []string {
 	var found []string
 Outer: